@@ -0,0 +1,13 @@
+// Command deadcode runs the deadcode analyzer over the given packages.
+// Pass -fix to rewrite flagged branches and statements out of the source.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/SkanUJkod/SkanUJkod/pkg/analysis/deadcode"
+)
+
+func main() {
+	singlechecker.Main(deadcode.Analyzer)
+}
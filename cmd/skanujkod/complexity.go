@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"os"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/SkanUJkod/SkanUJkod/pkg/metrics/complexity"
+)
+
+// runComplexity implements `skanujkod complexity [-json] [--fail-over N] <patterns...>`.
+func runComplexity(args []string) error {
+	fs := flag.NewFlagSet("complexity", flag.ExitOnError)
+	failOver := fs.Int("fail-over", 0, "exit with status 1 if any function's cognitive complexity exceeds this (0 disables the check)")
+	asJSON := fs.Bool("json", false, "print the report as JSON instead of a Markdown table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := packages.Load(&packages.Config{
+		Fset: fset,
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
+	}, patterns...)
+	if err != nil {
+		return fmt.Errorf("loading packages: %w", err)
+	}
+
+	var results []complexity.Result
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			path := fset.Position(file.Pos()).Filename
+			results = append(results, complexity.AnalyzeFile(fset, file, path)...)
+		}
+	}
+
+	if *asJSON {
+		out, err := complexity.JSON(results)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		fmt.Print(complexity.Markdown(results))
+	}
+
+	if *failOver > 0 {
+		for _, r := range results {
+			if r.Cognitive > *failOver {
+				fmt.Fprintf(os.Stderr, "skanujkod: %s: %s has cognitive complexity %d, exceeds --fail-over %d\n", r.File, r.Func, r.Cognitive, *failOver)
+				os.Exit(1)
+			}
+		}
+	}
+
+	return nil
+}
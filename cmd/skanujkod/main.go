@@ -0,0 +1,39 @@
+// Command skanujkod is the CLI entry point for the module's code-analysis
+// tools. It dispatches to a subcommand the way `go` itself does:
+// `skanujkod <command> [flags] <args>`.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "complexity":
+		err = runComplexity(os.Args[2:])
+	case "testaudit":
+		err = runTestAudit(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "skanujkod:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: skanujkod <command> [flags] <args>")
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  complexity ./...              report cyclomatic and cognitive complexity per function")
+	fmt.Fprintln(os.Stderr, "  testaudit [--revive] [--cover ./...] *_test.go   find disabled tests and uncovered functions")
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/SkanUJkod/SkanUJkod/pkg/testaudit"
+)
+
+// runTestAudit implements
+// `skanujkod testaudit [--revive] [--cover ./...] <_test.go files...>`.
+func runTestAudit(args []string) error {
+	fs := flag.NewFlagSet("testaudit", flag.ExitOnError)
+	revive := fs.Bool("revive", false, "print a patch that uncomments recognized disabled tests instead of reporting them")
+	cover := fs.String("cover", "", "package pattern to run `go test -cover` against for the uncovered-function check, e.g. ./...")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	testFiles := fs.Args()
+	if len(testFiles) == 0 {
+		return fmt.Errorf("usage: skanujkod testaudit [--revive] [--cover ./...] <file_test.go>...")
+	}
+
+	if *revive {
+		for _, f := range testFiles {
+			patch, err := testaudit.RevivePatch(f)
+			if err != nil {
+				return err
+			}
+			fmt.Print(patch)
+		}
+		return nil
+	}
+
+	var report testaudit.Report
+	for _, f := range testFiles {
+		found, err := testaudit.FindDisabledTests(f)
+		if err != nil {
+			return err
+		}
+		report.DisabledTests = append(report.DisabledTests, found...)
+	}
+
+	if *cover != "" {
+		coverage, err := testaudit.FuncCoverage(".", *cover)
+		if err != nil {
+			return err
+		}
+		for _, f := range testFiles {
+			source := strings.TrimSuffix(f, "_test.go") + ".go"
+			uncovered, err := testaudit.UncoveredFuncs(source, coverage)
+			if err != nil {
+				continue // source file may not exist alongside every _test.go
+			}
+			report.UncoveredFuncs = append(report.UncoveredFuncs, uncovered...)
+		}
+	}
+
+	fmt.Print(report.String())
+	return nil
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+    "fmt"
+    "sort"
     "testing"
+
+    "github.com/SkanUJkod/SkanUJkod/pkg/challenge"
 )
 
 // func TestCalculateGrade(t *testing.T) {
@@ -26,102 +30,79 @@ import (
 // }
 
 func TestSumArray(t *testing.T) {
-    tests := []struct {
-        numbers  []int
-        expected int
-    }{
-        {[]int{1, 2, 3, 4, 5}, 15},
-        {[]int{}, 0},
-        {[]int{-1, -2, 3}, 0},
-        {[]int{10}, 10},
-    }
-    
-    for _, tt := range tests {
-        result := sumArray(tt.numbers)
-        if result != tt.expected {
-            t.Errorf("sumArray(%v) = %d; want %d", 
-                tt.numbers, result, tt.expected)
-        }
-    }
+    challenge.Run(t, sumArray, referenceSumArray)
+}
+
+// referenceSumArray is a distinct implementation (range instead of an
+// index loop) challenge.Run checks sumArray against.
+func referenceSumArray(numbers []int) int {
+    total := 0
+    for _, n := range numbers {
+        total += n
+    }
+    return total
+}
+
+// referenceFindMax sorts instead of scanning, but must still return 0 on
+// an empty slice to match findMax's (documented-by-behavior) convention.
+func referenceFindMax(numbers []int) int {
+    if len(numbers) == 0 {
+        return 0
+    }
+    sorted := append([]int(nil), numbers...)
+    sort.Ints(sorted)
+    return sorted[len(sorted)-1]
 }
 
 func TestFindMax(t *testing.T) {
-    tests := []struct {
-        numbers  []int
-        expected int
-    }{
-        {[]int{3, 7, 2, 9, 1}, 9},
-        {[]int{}, 0},
-        {[]int{-5, -2, -10}, -2},
-        {[]int{42}, 42},
+    challenge.Run(t, findMax, referenceFindMax)
+}
+
+func referenceGetDayName(day int) string {
+    names := map[int]string{
+        1: "Monday", 2: "Tuesday", 3: "Wednesday", 4: "Thursday",
+        5: "Friday", 6: "Saturday", 7: "Sunday",
     }
-    
-    for _, tt := range tests {
-        result := findMax(tt.numbers)
-        if result != tt.expected {
-            t.Errorf("findMax(%v) = %d; want %d", 
-                tt.numbers, result, tt.expected)
-        }
+    if name, ok := names[day]; ok {
+        return name
     }
+    return "Invalid day"
 }
 
 func TestGetDayName(t *testing.T) {
-    tests := []struct {
-        day      int
-        expected string
-    }{
-        {1, "Monday"},
-        {5, "Friday"},
-        {7, "Sunday"},
-        {0, "Invalid day"},
-        {8, "Invalid day"},
-    }
-    
-    for _, tt := range tests {
-        result := getDayName(tt.day)
-        if result != tt.expected {
-            t.Errorf("getDayName(%d) = %s; want %s", 
-                tt.day, result, tt.expected)
-        }
+    challenge.Run(t, getDayName, referenceGetDayName)
+}
+
+func referenceDivide(a, b float64) (float64, error) {
+    if b == 0 {
+        return 0, fmt.Errorf("division by zero")
     }
+    return a / b, nil
 }
 
 func TestDivide(t *testing.T) {
-    // Test normal division
-    result, err := divide(10, 2)
-    if err != nil {
-        t.Errorf("divide(10, 2) returned error: %v", err)
-    }
-    if result != 5 {
-        t.Errorf("divide(10, 2) = %f; want 5", result)
-    }
-    
-    // Test division by zero
-    _, err = divide(10, 0)
-    if err == nil {
+    // challenge's float generator never lands on exactly 0, so the
+    // division-by-zero path needs an explicit check; everything else is
+    // covered by the differential test below.
+    if _, err := divide(10, 0); err == nil {
         t.Error("divide(10, 0) should return error")
     }
+    challenge.Run(t, divide, referenceDivide)
+}
+
+// referenceMinMax sorts instead of tracking running min/max, but must
+// still return (0, 0) on an empty slice to match minMax.
+func referenceMinMax(numbers []int) (int, int) {
+    if len(numbers) == 0 {
+        return 0, 0
+    }
+    sorted := append([]int(nil), numbers...)
+    sort.Ints(sorted)
+    return sorted[0], sorted[len(sorted)-1]
 }
 
 func TestMinMax(t *testing.T) {
-    tests := []struct {
-        numbers []int
-        min     int
-        max     int
-    }{
-        {[]int{5, 2, 8, 1, 9}, 1, 9},
-        {[]int{}, 0, 0},
-        {[]int{42}, 42, 42},
-        {[]int{-5, -2, -10}, -10, -2},
-    }
-    
-    for _, tt := range tests {
-        min, max := minMax(tt.numbers)
-        if min != tt.min || max != tt.max {
-            t.Errorf("minMax(%v) = (%d, %d); want (%d, %d)", 
-                tt.numbers, min, max, tt.min, tt.max)
-        }
-    }
+    challenge.Run(t, minMax, referenceMinMax)
 }
 
 func TestProcessFile(t *testing.T) {
@@ -0,0 +1,163 @@
+// Package deadcode is a go/analysis pass that flags code that can never
+// run: branches guarded by a constant condition, statements following a
+// terminating statement, and labeled blocks no control-flow edge ever
+// reaches.
+package deadcode
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/SkanUJkod/SkanUJkod/pkg/cfg"
+)
+
+// Analyzer reports dead code. It composes with
+// golang.org/x/tools/go/analysis/singlechecker, and when run with -fix it
+// rewrites the AST to drop the reported branch or statements.
+var Analyzer = &analysis.Analyzer{
+	Name:     "deadcode",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+const doc = `report unreachable code
+
+The deadcode analyzer flags two kinds of unreachable code in a function
+body:
+
+  - a branch whose condition constant-folds to a fixed boolean, e.g.
+    "if false { ... }" or "if true { ... } else { ... }";
+  - statements, and labels, that have no path from the function's entry
+    block in its control-flow graph. This is CFG-based rather than purely
+    lexical: a statement right after a return is only reported if nothing
+    in the function, including a goto arriving from somewhere else
+    entirely, ever reaches it.`
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{(*ast.FuncDecl)(nil)}
+	insp.Preorder(nodeFilter, func(n ast.Node) {
+		fn := n.(*ast.FuncDecl)
+		if fn.Body == nil {
+			return
+		}
+		checkConstantBranches(pass, fn.Body)
+		checkUnreachableBlocks(pass, fn)
+	})
+
+	return nil, nil
+}
+
+// checkConstantBranches walks every *ast.IfStmt in body and reports the
+// branch that provably never executes when the condition constant-folds.
+func checkConstantBranches(pass *analysis.Pass, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		ifStmt, ok := n.(*ast.IfStmt)
+		if !ok {
+			return true
+		}
+
+		tv, ok := pass.TypesInfo.Types[ifStmt.Cond]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.Bool {
+			return true
+		}
+
+		if constant.BoolVal(tv.Value) {
+			if ifStmt.Else != nil {
+				pass.Report(analysis.Diagnostic{
+					Pos:     ifStmt.Else.Pos(),
+					End:     ifStmt.Else.End(),
+					Message: "else branch is unreachable: condition is always true",
+					SuggestedFixes: []analysis.SuggestedFix{{
+						Message: "remove the dead else branch",
+						TextEdits: []analysis.TextEdit{{
+							Pos: elseDeletionStart(ifStmt),
+							End: ifStmt.Else.End(),
+						}},
+					}},
+				})
+			}
+		} else {
+			pass.Report(analysis.Diagnostic{
+				Pos:     ifStmt.Body.Pos(),
+				End:     ifStmt.Body.End(),
+				Message: "branch is unreachable: condition is always false",
+				SuggestedFixes: []analysis.SuggestedFix{{
+					Message: "remove the dead branch",
+					TextEdits: []analysis.TextEdit{{
+						Pos: ifStmt.Pos(),
+						End: deadBranchDeletionEnd(ifStmt),
+					}},
+				}},
+			})
+		}
+		return true
+	})
+}
+
+// elseDeletionStart returns the position right after the if-body's closing
+// brace, so the "} else { ... }" tail is removed without touching the
+// still-live then-branch.
+func elseDeletionStart(ifStmt *ast.IfStmt) token.Pos {
+	return ifStmt.Body.End()
+}
+
+// deadBranchDeletionEnd returns where the deletion of a constant-false
+// branch stops: at the start of the else branch if present (so
+// "if false {A} else {B}" collapses to B), or at the end of the if
+// statement itself.
+func deadBranchDeletionEnd(ifStmt *ast.IfStmt) token.Pos {
+	if ifStmt.Else != nil {
+		return ifStmt.Else.Pos()
+	}
+	return ifStmt.End()
+}
+
+// checkUnreachableBlocks builds fn's control-flow graph and reports every
+// block with no path from entry: once as a run of dead statements if it
+// holds any, and once as an orphan label if it's one of the blocks a
+// goto/break/continue can target. Basing this on pkg/cfg rather than "does
+// this block lexically follow a terminating statement" means a goto that
+// jumps into what looks like dead code from elsewhere in the function
+// correctly suppresses the report.
+func checkUnreachableBlocks(pass *analysis.Pass, fn *ast.FuncDecl) {
+	g := cfg.Build(fn)
+	live := cfg.Reachable(g)
+
+	for _, block := range g.Blocks {
+		if block == g.Exit || live[block] {
+			continue
+		}
+
+		if len(block.Stmts) > 0 {
+			first, last := block.Stmts[0], block.Stmts[len(block.Stmts)-1]
+			pass.Report(analysis.Diagnostic{
+				Pos:     first.Pos(),
+				End:     last.End(),
+				Message: "unreachable code: statement(s) follow a terminating statement",
+				SuggestedFixes: []analysis.SuggestedFix{{
+					Message: "remove the unreachable statements",
+					TextEdits: []analysis.TextEdit{{
+						Pos: first.Pos(),
+						End: last.End(),
+					}},
+				}},
+			})
+		}
+
+		if block.Label != "" && block.LabelPos != token.NoPos {
+			pass.Report(analysis.Diagnostic{
+				Pos:     block.LabelPos,
+				End:     block.LabelPos + token.Pos(len(block.Label)),
+				Message: "label \"" + block.Label + "\" has no incoming control-flow edge from entry",
+			})
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package deadcode_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/SkanUJkod/SkanUJkod/pkg/analysis/deadcode"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), deadcode.Analyzer, "a")
+}
@@ -0,0 +1,44 @@
+package a
+
+func deadCodeExample(x int) int {
+	if false { // want "branch is unreachable: condition is always false"
+		return -1
+	} else {
+		return x * 2
+	}
+
+	y := x + 100 // want "unreachable code: statement\\(s\\) follow a terminating statement"
+	return y
+}
+
+func alwaysTrueElse(x int) int {
+	if true {
+		return x
+	} else { // want "else branch is unreachable: condition is always true"
+		return -x
+	}
+}
+
+func unreachableLabel(n int) int {
+	if n > 0 {
+		return n
+	}
+	return -n
+
+done: // want "label \"done\" has no incoming control-flow edge from entry"
+	goto done // want "unreachable code: statement\\(s\\) follow a terminating statement"
+}
+
+// reachableViaGoto must not be flagged anywhere: the goto before the
+// early return jumps into what would, lexically, look like dead code
+// following "return n * 2", but "skip:" is reachable via that goto, so
+// none of it is actually unreachable.
+func reachableViaGoto(n int) int {
+	if n < 0 {
+		goto skip
+	}
+	return n * 2
+
+skip:
+	return -1
+}
@@ -0,0 +1,337 @@
+package cfg
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Build constructs the control-flow graph of fn. Unresolved forward
+// references (a goto or labeled break/continue that names a label not yet
+// seen) are wired up lazily: labelBlock creates a block for a label the
+// first time it is *named*, whether that happens at the goto site or at
+// the label's own declaration, so a forward goto and the label it targets
+// always end up pointing at the same block regardless of which one the
+// single walk over the AST reaches first.
+func Build(fn *ast.FuncDecl) *Graph {
+	g := &Graph{Labels: map[string]*Block{}}
+	g.Exit = newBlock(g, "")
+
+	if fn.Body == nil {
+		g.Entry = newBlock(g, "")
+		return g
+	}
+
+	b := &builder{g: g}
+	g.Entry = newBlock(g, "")
+	b.buildList(fn.Body.List, g.Entry)
+	return g
+}
+
+// loopFrame records the break/continue targets for one enclosing
+// for/range loop or switch. continueTarget is nil for a switch, since
+// "continue" inside a switch continues the nearest enclosing loop, not the
+// switch itself; resolveContinue skips frames with a nil continueTarget.
+type loopFrame struct {
+	label          string
+	breakTarget    *Block
+	continueTarget *Block
+}
+
+type builder struct {
+	g     *Graph
+	loops []loopFrame
+	// fallthroughs is a stack of "fallthrough" targets, one per switch
+	// clause currently being built: the entry block of the clause
+	// immediately after it. It's pushed/popped around each clause's body
+	// the same way loops is pushed/popped around a loop/switch body.
+	fallthroughs []*Block
+}
+
+func (b *builder) labelBlock(name string) *Block {
+	if blk, ok := b.g.Labels[name]; ok {
+		return blk
+	}
+	blk := newBlock(b.g, name)
+	b.g.Labels[name] = blk
+	return blk
+}
+
+// buildList threads cur through stmts in order and returns the block later
+// statements should attach to, or nil if the list is guaranteed to have
+// already left the function (or jumped away) by its end.
+func (b *builder) buildList(stmts []ast.Stmt, cur *Block) *Block {
+	for _, stmt := range stmts {
+		cur = b.buildStmt(stmt, cur, "")
+	}
+	return cur
+}
+
+// buildStmt extends the graph with stmt and returns the successor block,
+// or nil if stmt always transfers control away. label is the name of the
+// ast.LabeledStmt directly wrapping stmt, if any, and is only consulted by
+// for/range/switch so that labeled break/continue can target them.
+func (b *builder) buildStmt(stmt ast.Stmt, cur *Block, label string) *Block {
+	switch s := stmt.(type) {
+	case *ast.LabeledStmt:
+		switch s.Stmt.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt:
+			// Labeled break/continue match a label on one of these by
+			// name against the loops stack rather than through g.Labels,
+			// and the loop's own header block doubles as the label's
+			// block, so tests can look it up by name. A goto may also
+			// name such a label (it jumps to the statement, not into its
+			// body): if a forward goto already created a block for the
+			// label, control is routed through that block into the
+			// statement so the goto's edge reaches it too.
+			if pending, ok := b.g.Labels[s.Label.Name]; ok {
+				addEdge(cur, pending)
+				cur = pending
+			}
+			return b.buildStmt(s.Stmt, cur, s.Label.Name)
+		default:
+			target := b.labelBlock(s.Label.Name)
+			if target.LabelPos == token.NoPos {
+				target.LabelPos = s.Label.Pos()
+			}
+			addEdge(cur, target)
+			return b.buildStmt(s.Stmt, target, "")
+		}
+
+	case *ast.BranchStmt:
+		return b.buildBranch(s, cur)
+
+	case *ast.ReturnStmt:
+		cur = b.appendSimple(stmt, cur)
+		addEdge(cur, b.g.Exit)
+		return nil
+
+	case *ast.BlockStmt:
+		return b.buildList(s.List, cur)
+
+	case *ast.IfStmt:
+		return b.buildIf(s, cur)
+
+	case *ast.ForStmt:
+		return b.buildFor(s, cur, label)
+
+	case *ast.RangeStmt:
+		return b.buildRange(s, cur, label)
+
+	case *ast.SwitchStmt:
+		return b.buildSwitch(s, cur, label)
+
+	default:
+		return b.appendSimple(stmt, cur)
+	}
+}
+
+// appendSimple handles statements with no control-flow edges of their own
+// (assignments, expression statements, declarations, go/defer, ...). If
+// cur is nil — the statement is unreachable, e.g. it follows an
+// unconditional return — it still gets a block of its own so callers can
+// inspect dead code, that block just starts with no predecessors.
+func (b *builder) appendSimple(stmt ast.Stmt, cur *Block) *Block {
+	if cur == nil {
+		cur = newBlock(b.g, "")
+	}
+	cur.Stmts = append(cur.Stmts, stmt)
+	return cur
+}
+
+func (b *builder) buildBranch(s *ast.BranchStmt, cur *Block) *Block {
+	switch s.Tok {
+	case token.GOTO:
+		cur = b.appendSimple(s, cur)
+		addEdge(cur, b.labelBlock(s.Label.Name))
+		return nil
+	case token.BREAK:
+		cur = b.appendSimple(s, cur)
+		addEdge(cur, b.resolveBreak(labelName(s.Label)))
+		return nil
+	case token.CONTINUE:
+		cur = b.appendSimple(s, cur)
+		addEdge(cur, b.resolveContinue(labelName(s.Label)))
+		return nil
+	case token.FALLTHROUGH:
+		cur = b.appendSimple(s, cur)
+		addEdge(cur, b.resolveFallthrough())
+		return nil
+	default:
+		return b.appendSimple(s, cur)
+	}
+}
+
+func labelName(ident *ast.Ident) string {
+	if ident == nil {
+		return ""
+	}
+	return ident.Name
+}
+
+func (b *builder) resolveBreak(label string) *Block {
+	for i := len(b.loops) - 1; i >= 0; i-- {
+		if label == "" || b.loops[i].label == label {
+			return b.loops[i].breakTarget
+		}
+	}
+	return b.g.Exit
+}
+
+func (b *builder) resolveContinue(label string) *Block {
+	for i := len(b.loops) - 1; i >= 0; i-- {
+		if b.loops[i].continueTarget == nil {
+			continue // a switch frame: continue passes through to the enclosing loop.
+		}
+		if label == "" || b.loops[i].label == label {
+			return b.loops[i].continueTarget
+		}
+	}
+	return b.g.Exit
+}
+
+// resolveFallthrough returns the entry block of the clause after the one
+// currently being built. The Go spec only allows "fallthrough" as the
+// final statement of a non-last case clause, so this is always called
+// with a matching entry on top of the stack.
+func (b *builder) resolveFallthrough() *Block {
+	return b.fallthroughs[len(b.fallthroughs)-1]
+}
+
+func (b *builder) buildIf(s *ast.IfStmt, cur *Block) *Block {
+	if s.Init != nil {
+		cur = b.buildStmt(s.Init, cur, "")
+	}
+
+	thenEntry := newBlock(b.g, "")
+	addEdge(cur, thenEntry)
+	thenExit := b.buildStmt(s.Body, thenEntry, "")
+
+	var elseExit *Block
+	if s.Else != nil {
+		elseEntry := newBlock(b.g, "")
+		addEdge(cur, elseEntry)
+		elseExit = b.buildStmt(s.Else, elseEntry, "")
+	}
+
+	join := newBlock(b.g, "")
+	addEdge(thenExit, join)
+	if s.Else != nil {
+		addEdge(elseExit, join)
+	} else {
+		addEdge(cur, join) // falls straight through when the condition is false
+	}
+
+	if len(join.Preds) == 0 {
+		return nil
+	}
+	return join
+}
+
+func (b *builder) buildFor(s *ast.ForStmt, cur *Block, label string) *Block {
+	if s.Init != nil {
+		cur = b.buildStmt(s.Init, cur, "")
+	}
+
+	header := newBlock(b.g, label)
+	if label != "" {
+		b.g.Labels[label] = header
+	}
+	addEdge(cur, header)
+
+	after := newBlock(b.g, "")
+	bodyEntry := newBlock(b.g, "")
+	addEdge(header, bodyEntry)
+	if s.Cond != nil {
+		addEdge(header, after) // condition false: leave the loop
+	}
+
+	var post *Block
+	continueTarget := header
+	if s.Post != nil {
+		post = newBlock(b.g, "")
+		continueTarget = post
+	}
+
+	b.loops = append(b.loops, loopFrame{label: label, breakTarget: after, continueTarget: continueTarget})
+	bodyExit := b.buildList(s.Body.List, bodyEntry)
+	b.loops = b.loops[:len(b.loops)-1]
+
+	if post != nil {
+		addEdge(bodyExit, post)
+		postExit := b.buildStmt(s.Post, post, "")
+		addEdge(postExit, header)
+	} else {
+		addEdge(bodyExit, header)
+	}
+
+	return after
+}
+
+// buildRange models a range loop the same way as an unconditional for: the
+// header always has a path both into the body and out to after, since
+// ranging over an empty collection runs zero iterations.
+func (b *builder) buildRange(s *ast.RangeStmt, cur *Block, label string) *Block {
+	header := newBlock(b.g, label)
+	if label != "" {
+		b.g.Labels[label] = header
+	}
+	addEdge(cur, header)
+
+	after := newBlock(b.g, "")
+	bodyEntry := newBlock(b.g, "")
+	addEdge(header, bodyEntry)
+	addEdge(header, after)
+
+	b.loops = append(b.loops, loopFrame{label: label, breakTarget: after, continueTarget: header})
+	bodyExit := b.buildList(s.Body.List, bodyEntry)
+	b.loops = b.loops[:len(b.loops)-1]
+
+	addEdge(bodyExit, header)
+	return after
+}
+
+func (b *builder) buildSwitch(s *ast.SwitchStmt, cur *Block, label string) *Block {
+	if s.Init != nil {
+		cur = b.buildStmt(s.Init, cur, "")
+	}
+	if label != "" {
+		b.g.Labels[label] = cur
+	}
+
+	after := newBlock(b.g, "")
+	b.loops = append(b.loops, loopFrame{label: label, breakTarget: after, continueTarget: nil})
+
+	var clauses []*ast.CaseClause
+	for _, clause := range s.Body.List {
+		if cc, ok := clause.(*ast.CaseClause); ok {
+			clauses = append(clauses, cc)
+		}
+	}
+
+	entries := make([]*Block, len(clauses))
+	hasDefault := false
+	for i := range clauses {
+		entries[i] = newBlock(b.g, "")
+		addEdge(cur, entries[i])
+		if clauses[i].List == nil {
+			hasDefault = true
+		}
+	}
+	if !hasDefault {
+		addEdge(cur, after) // no case matches and there is no default clause
+	}
+
+	for i, cc := range clauses {
+		var fallthroughTarget *Block
+		if i+1 < len(entries) {
+			fallthroughTarget = entries[i+1]
+		}
+		b.fallthroughs = append(b.fallthroughs, fallthroughTarget)
+		caseExit := b.buildList(cc.Body, entries[i])
+		b.fallthroughs = b.fallthroughs[:len(b.fallthroughs)-1]
+		addEdge(caseExit, after)
+	}
+
+	b.loops = b.loops[:len(b.loops)-1]
+	return after
+}
@@ -0,0 +1,198 @@
+package cfg_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/SkanUJkod/SkanUJkod/pkg/cfg"
+)
+
+func parseFunc(t *testing.T, src string) *ast.FuncDecl {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "fixture.go", "package p\n"+src, 0)
+	if err != nil {
+		t.Fatalf("parsing fixture: %v", err)
+	}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			return fn
+		}
+	}
+	t.Fatal("fixture has no function declaration")
+	return nil
+}
+
+const complexFlow = `
+func complexFlow(n int) int {
+	result := 0
+	i := 0
+
+loop:
+	if i >= n {
+		goto end
+	}
+	result += i
+	i++
+	goto loop
+
+end:
+	return result
+}
+`
+
+func TestBuild_GotoLoopHasBackEdgeAndReachesEnd(t *testing.T) {
+	fn := parseFunc(t, complexFlow)
+	g := cfg.Build(fn)
+
+	loopBlock, ok := g.Labels["loop"]
+	if !ok {
+		t.Fatal("no block registered for label \"loop\"")
+	}
+	endBlock, ok := g.Labels["end"]
+	if !ok {
+		t.Fatal("no block registered for label \"end\"")
+	}
+
+	live := cfg.Reachable(g)
+	if !live[loopBlock] {
+		t.Error("loop: block is not reachable from entry")
+	}
+	if !live[endBlock] {
+		t.Error("end: block is not reachable from entry")
+	}
+
+	// The "goto loop" at the bottom of the function must close a cycle
+	// back to the loop label, i.e. loopBlock must have a predecessor other
+	// than the block that falls into it from entry.
+	if len(loopBlock.Preds) < 2 {
+		t.Fatalf("loop: block has %d predecessor(s), want at least 2 (entry fallthrough + goto loop back-edge)", len(loopBlock.Preds))
+	}
+}
+
+func TestBuild_OrphanLabelHasNoIncomingEdge(t *testing.T) {
+	const src = `
+func orphan(n int) int {
+	if n > 0 {
+		return n
+	}
+	return -n
+
+dead:
+	return 0
+}
+`
+	fn := parseFunc(t, src)
+	g := cfg.Build(fn)
+
+	deadBlock, ok := g.Labels["dead"]
+	if !ok {
+		t.Fatal("no block registered for label \"dead\"")
+	}
+	if len(deadBlock.Preds) != 0 {
+		t.Errorf("dead: block has %d predecessor(s), want 0 (nothing goto's it and it follows an unconditional return)", len(deadBlock.Preds))
+	}
+	if cfg.Reachable(g)[deadBlock] {
+		t.Error("dead: block is reachable from entry, want unreachable")
+	}
+}
+
+func TestBuild_LabeledBreakTargetsOuterLoop(t *testing.T) {
+	const src = `
+func search(matrix [][]int, target int) bool {
+outer:
+	for _, row := range matrix {
+		for _, v := range row {
+			if v == target {
+				break outer
+			}
+		}
+	}
+	return false
+}
+`
+	fn := parseFunc(t, src)
+	g := cfg.Build(fn)
+
+	outerHeader, ok := g.Labels["outer"]
+	if !ok {
+		t.Fatal("no block registered for label \"outer\"")
+	}
+
+	// outerHeader's range always has exactly two successors: into the
+	// body, and out to "after" once the outer range is exhausted. That
+	// same "after" block is also where "break outer" from two loops deep
+	// must land, so it should show up with more than one predecessor.
+	if len(outerHeader.Succs) != 2 {
+		t.Fatalf("outer: header has %d successor(s), want 2 (body entry + exhaustion edge)", len(outerHeader.Succs))
+	}
+	after := outerHeader.Succs[1]
+	if len(after.Preds) < 2 {
+		t.Errorf("outer loop's after-block has %d predecessor(s), want at least 2 (normal exhaustion + labeled break)", len(after.Preds))
+	}
+	if !cfg.Reachable(g)[after] {
+		t.Error("outer loop's after-block is not reachable from entry")
+	}
+}
+
+func TestBuild_FallthroughTargetsNextCaseEntry(t *testing.T) {
+	const src = `
+func classify(n int) string {
+	switch n {
+	case 1:
+		foo()
+		fallthrough
+	case 2:
+		bar()
+		return "small"
+	default:
+		return "other"
+	}
+}
+`
+	fn := parseFunc(t, src)
+	g := cfg.Build(fn)
+
+	case1 := blockCalling(t, g, "foo")
+	case2 := blockCalling(t, g, "bar")
+
+	if len(case1.Succs) != 1 || case1.Succs[0] != case2 {
+		t.Fatalf("case 1's block has successors %v, want exactly [case 2's block]", case1.Succs)
+	}
+	if !cfg.Reachable(g)[case2] {
+		t.Error("case 2's block is not reachable from entry")
+	}
+}
+
+// blockCalling returns the block whose Stmts contains a call to the
+// niladic function named name, failing the test if there isn't exactly
+// one.
+func blockCalling(t *testing.T, g *cfg.Graph, name string) *cfg.Block {
+	t.Helper()
+	var found *cfg.Block
+	for _, b := range g.Blocks {
+		for _, stmt := range b.Stmts {
+			expr, ok := stmt.(*ast.ExprStmt)
+			if !ok {
+				continue
+			}
+			call, ok := expr.X.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			ident, ok := call.Fun.(*ast.Ident)
+			if !ok || ident.Name != name {
+				continue
+			}
+			if found != nil {
+				t.Fatalf("more than one block calls %s", name)
+			}
+			found = b
+		}
+	}
+	if found == nil {
+		t.Fatalf("no block calls %s", name)
+	}
+	return found
+}
@@ -0,0 +1,87 @@
+// Package cfg builds a control-flow graph for a single Go function,
+// including the constructs naive block builders tend to get wrong:
+// labeled statements, goto (forward and backward), and labeled
+// break/continue out of nested loops and switches.
+package cfg
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Block is a maximal straight-line sequence of statements: control only
+// enters at its first statement and only leaves at its last.
+type Block struct {
+	// ID is the block's index into Graph.Blocks.
+	ID int
+	// Label is the name of the ast.LabeledStmt this block starts with, or
+	// "" if the block isn't a goto/break/continue target.
+	Label string
+	// LabelPos is the position of the label identifier this block starts
+	// with, or token.NoPos if Label == "" or the block is a loop/switch
+	// header (which doubles as its label's block, but isn't itself a
+	// *ast.LabeledStmt).
+	LabelPos token.Pos
+	// Stmts are the statements belonging to this block, in order. It
+	// excludes the *ast.LabeledStmt wrapper itself; the wrapped statement
+	// is the first (and possibly only) entry. A block's last statement,
+	// if any, may be a return, goto, break, or continue: these end the
+	// block but are still recorded here so callers can see exactly what
+	// the block contains.
+	Stmts []ast.Stmt
+	// Preds and Succs are this block's predecessors and successors in the
+	// graph. A block with an empty Preds list (other than the entry
+	// block) has no incoming control-flow edge from anywhere in the
+	// function.
+	Preds []*Block
+	Succs []*Block
+}
+
+// Graph is the control-flow graph of one function.
+type Graph struct {
+	// Entry is the function body's first block.
+	Entry *Block
+	// Exit is a synthetic block every return statement flows into.
+	Exit *Block
+	// Blocks holds every block in the graph, including unreachable ones,
+	// in the order they were created.
+	Blocks []*Block
+	// Labels maps a label name declared in the function to the block it
+	// introduces.
+	Labels map[string]*Block
+}
+
+func newBlock(g *Graph, label string) *Block {
+	b := &Block{ID: len(g.Blocks), Label: label}
+	g.Blocks = append(g.Blocks, b)
+	return b
+}
+
+func addEdge(from, to *Block) {
+	if from == nil || to == nil {
+		return
+	}
+	from.Succs = append(from.Succs, to)
+	to.Preds = append(to.Preds, from)
+}
+
+// Reachable returns the set of blocks reachable from g.Entry by following
+// Succs, including Entry itself. A block missing from the result has no
+// path from entry at all, regardless of what its own Preds list says (a
+// block can have predecessors that are themselves unreachable, e.g. a
+// goto inside dead code jumping to another dead label).
+func Reachable(g *Graph) map[*Block]bool {
+	seen := map[*Block]bool{g.Entry: true}
+	stack := []*Block{g.Entry}
+	for len(stack) > 0 {
+		b := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, succ := range b.Succs {
+			if !seen[succ] {
+				seen[succ] = true
+				stack = append(stack, succ)
+			}
+		}
+	}
+	return seen
+}
@@ -0,0 +1,82 @@
+package challenge
+
+import (
+	"strings"
+	"testing"
+)
+
+func referenceIsPalindrome(s string) bool {
+	s = strings.ToLower(s)
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		if runes[i] != runes[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// brokenIsPalindrome mishandles the empty string, which Run should catch.
+func brokenIsPalindrome(s string) bool {
+	if s == "" {
+		return false
+	}
+	return referenceIsPalindrome(s)
+}
+
+func referenceMax(nums []int) int {
+	max := nums[0]
+	for _, v := range nums {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// safeMax fixes the reference's panic on an empty slice.
+func safeMax(nums []int) int {
+	if len(nums) == 0 {
+		return 0
+	}
+	return referenceMax(nums)
+}
+
+func TestRun_AgreesOnEquivalentImplementations(t *testing.T) {
+	Run(t, referenceIsPalindrome, referenceIsPalindrome, WithSeed(42), WithTrials(50))
+}
+
+func TestRun_FindsStringDivergence(t *testing.T) {
+	fake := &testing.T{}
+	recovered := runExpectingFailure(t, fake, brokenIsPalindrome, referenceIsPalindrome)
+	if !recovered {
+		t.Fatal("challenge.Run did not detect the empty-string divergence")
+	}
+}
+
+func TestRun_TreatsPanicsAsDivergences(t *testing.T) {
+	fake := &testing.T{}
+	recovered := runExpectingFailure(t, fake, referenceMax, safeMax)
+	if !recovered {
+		t.Fatal("challenge.Run did not detect that referenceMax panics on []int{} while safeMax does not")
+	}
+}
+
+// runExpectingFailure runs Run against a scratch *testing.T and reports
+// whether it called Fatalf, without letting that Fatalf abort this test's
+// own goroutine.
+func runExpectingFailure(t *testing.T, scratch *testing.T, student, reference interface{}) (failed bool) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		defer func() {
+			if recover() != nil {
+				failed = true
+			}
+			close(done)
+		}()
+		Run(scratch, student, reference, WithSeed(7), WithTrials(100))
+	}()
+	<-done
+	return failed || scratch.Failed()
+}
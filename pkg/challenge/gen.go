@@ -0,0 +1,86 @@
+package challenge
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// generateArgs builds one randomized argument list matching fnType's
+// parameter list.
+func generateArgs(fnType reflect.Type, rng *rand.Rand, cfg *config) []reflect.Value {
+	args := make([]reflect.Value, fnType.NumIn())
+	for i := range args {
+		args[i] = generateValue(fnType.In(i), rng, cfg)
+	}
+	return args
+}
+
+// generateValue produces a single randomized value of typ. It panics if typ
+// isn't one of the supported kinds, which is a programmer error (the
+// fixture's signature isn't one challenge.Run can drive) rather than a
+// condition a test should recover from.
+func generateValue(typ reflect.Type, rng *rand.Rand, cfg *config) reflect.Value {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(genInt(rng, cfg)).Convert(typ)
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(genFloat(rng)).Convert(typ)
+	case reflect.Bool:
+		return reflect.ValueOf(rng.Intn(2) == 0)
+	case reflect.String:
+		return reflect.ValueOf(cfg.stringGen(rng))
+	case reflect.Slice:
+		return generateSlice(typ, rng, cfg)
+	default:
+		panic("challenge: unsupported parameter type " + typ.String())
+	}
+}
+
+func generateSlice(typ reflect.Type, rng *rand.Rand, cfg *config) reflect.Value {
+	n := genLen(rng, cfg)
+	slice := reflect.MakeSlice(typ, n, n)
+	for i := 0; i < n; i++ {
+		slice.Index(i).Set(generateValue(typ.Elem(), rng, cfg))
+	}
+	return slice
+}
+
+func genInt(rng *rand.Rand, cfg *config) int {
+	span := cfg.intHi - cfg.intLo + 1
+	if span <= 0 {
+		return cfg.intLo
+	}
+	return cfg.intLo + rng.Intn(span)
+}
+
+func genFloat(rng *rand.Rand) float64 {
+	return (rng.Float64() - 0.5) * 200
+}
+
+// genLen picks a slice length: zero one time in ten (the most common
+// off-by-one source), otherwise uniform in [0, hi-lo] capped at 32, or in
+// [0, 10] when hi <= lo. Strings take their length from cfg.stringGen.
+func genLen(rng *rand.Rand, cfg *config) int {
+	if rng.Intn(10) == 0 {
+		return 0
+	}
+	max := cfg.intHi - cfg.intLo
+	if max <= 0 {
+		max = 10
+	}
+	if max > 32 {
+		max = 32
+	}
+	return rng.Intn(max + 1)
+}
+
+var runeAlphabet = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 ąęłóśżźćń")
+
+func defaultStringGen(rng *rand.Rand) string {
+	n := rng.Intn(17)
+	runes := make([]rune, n)
+	for i := range runes {
+		runes[i] = runeAlphabet[rng.Intn(len(runeAlphabet))]
+	}
+	return string(runes)
+}
@@ -0,0 +1,64 @@
+package challenge
+
+import "math/rand"
+
+// config holds the tunable parameters for a Run. Zero value config is never
+// used directly; newConfig fills in defaults before applying options.
+type config struct {
+	seed      int64
+	trials    int
+	maxShrink int
+	intLo     int
+	intHi     int
+	stringGen func(*rand.Rand) string
+}
+
+// Option configures a Run. Options are applied in the order they are
+// passed to Run, so later options override earlier ones.
+type Option func(*config)
+
+// WithSeed fixes the PRNG seed used to generate inputs, so a failure found
+// during one run can be reproduced exactly by passing the same seed.
+func WithSeed(seed int64) Option {
+	return func(c *config) { c.seed = seed }
+}
+
+// WithTrials sets how many randomized inputs Run tries before concluding
+// student and reference agree. The default is 200.
+func WithTrials(n int) Option {
+	return func(c *config) { c.trials = n }
+}
+
+// WithMaxShrink bounds how many shrink steps Run performs while minimizing
+// a failing input. The default is 100.
+func WithMaxShrink(n int) Option {
+	return func(c *config) { c.maxShrink = n }
+}
+
+// WithIntRange restricts generated ints to [lo, hi]. The default range is
+// [-100, 100]. Generated slice lengths are drawn from [0, hi-lo], capped at
+// 32 (or from [0, 10] when hi <= lo); string lengths do not depend on it.
+func WithIntRange(lo, hi int) Option {
+	return func(c *config) { c.intLo, c.intHi = lo, hi }
+}
+
+// WithStringGen overrides the default string generator, e.g. to bias
+// towards palindromes, unicode, or a fixed alphabet.
+func WithStringGen(gen func(*rand.Rand) string) Option {
+	return func(c *config) { c.stringGen = gen }
+}
+
+func newConfig(opts ...Option) *config {
+	c := &config{
+		seed:      1,
+		trials:    200,
+		maxShrink: 100,
+		intLo:     -100,
+		intHi:     100,
+		stringGen: defaultStringGen,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
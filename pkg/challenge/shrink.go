@@ -0,0 +1,131 @@
+package challenge
+
+import "reflect"
+
+// shrink repeatedly tries smaller variants of a failing argument list,
+// keeping any variant that still reproduces a divergence, until no smaller
+// variant fails or maxSteps is exhausted. It returns the smallest failing
+// input found and the difference it produces.
+func shrink(student, reference reflect.Value, fnType reflect.Type, args []reflect.Value, maxSteps int) ([]reflect.Value, string) {
+	best := args
+	bestDiff := compare(student, reference, best)
+
+	for step := 0; step < maxSteps; step++ {
+		progressed := false
+
+		for i := range best {
+			for _, candidateVal := range shrinkCandidates(best[i]) {
+				trial := cloneArgs(best)
+				trial[i] = candidateVal
+				if diff := compare(student, reference, trial); diff != "" {
+					best = trial
+					bestDiff = diff
+					progressed = true
+					break
+				}
+			}
+			if progressed {
+				break
+			}
+		}
+
+		if !progressed {
+			break
+		}
+	}
+
+	return best, bestDiff
+}
+
+func cloneArgs(args []reflect.Value) []reflect.Value {
+	clone := make([]reflect.Value, len(args))
+	copy(clone, args)
+	return clone
+}
+
+// shrinkCandidates returns a list of strictly-smaller-or-simpler values to
+// try in place of v, ordered roughly from most-aggressive to least. The
+// caller keeps the first one that still reproduces the failure.
+func shrinkCandidates(v reflect.Value) []reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		return shrinkSlice(v)
+	case reflect.String:
+		return shrinkString(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return shrinkInt(v)
+	case reflect.Float32, reflect.Float64:
+		return shrinkFloat(v)
+	default:
+		return nil
+	}
+}
+
+func shrinkSlice(v reflect.Value) []reflect.Value {
+	n := v.Len()
+	if n == 0 {
+		return nil
+	}
+
+	var out []reflect.Value
+	out = append(out, v.Slice(0, n/2), v.Slice(n/2, n))
+	for i := 0; i < n; i++ {
+		without := reflect.MakeSlice(v.Type(), 0, n-1)
+		without = reflect.AppendSlice(without, v.Slice(0, i))
+		without = reflect.AppendSlice(without, v.Slice(i+1, n))
+		out = append(out, without)
+	}
+	return out
+}
+
+func shrinkString(v reflect.Value) []reflect.Value {
+	runes := []rune(v.String())
+	n := len(runes)
+	if n == 0 {
+		return nil
+	}
+
+	var out []reflect.Value
+	out = append(out, reflect.ValueOf(string(runes[:n/2])), reflect.ValueOf(string(runes[n/2:])))
+	for i := 0; i < n; i++ {
+		without := make([]rune, 0, n-1)
+		without = append(without, runes[:i]...)
+		without = append(without, runes[i+1:]...)
+		out = append(out, reflect.ValueOf(string(without)))
+	}
+	return out
+}
+
+func shrinkInt(v reflect.Value) []reflect.Value {
+	n := v.Int()
+	if n == 0 {
+		return nil
+	}
+	half := n / 2
+	candidates := []int64{0, half, n - sign(n)}
+	out := make([]reflect.Value, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, reflect.ValueOf(c).Convert(v.Type()))
+	}
+	return out
+}
+
+func shrinkFloat(v reflect.Value) []reflect.Value {
+	f := v.Float()
+	if f == 0 {
+		return nil
+	}
+	candidates := []float64{0, f / 2}
+	out := make([]reflect.Value, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, reflect.ValueOf(c).Convert(v.Type()))
+	}
+	return out
+}
+
+func sign(n int64) int64 {
+	if n < 0 {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,220 @@
+// Package complexity computes two per-function complexity metrics: McCabe
+// cyclomatic complexity (how many independent paths through the function)
+// and Sonar-style cognitive complexity (how hard the function is to read,
+// which weighs nesting more heavily than raw branch count).
+package complexity
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Result is one function's complexity report.
+type Result struct {
+	File       string `json:"file"`
+	Func       string `json:"func"`
+	Cyclomatic int    `json:"cyclomatic"`
+	Cognitive  int    `json:"cognitive"`
+	Lines      int    `json:"lines"`
+}
+
+// AnalyzeFile computes a Result for every function declared in file.
+// Methods are reported with their receiver type as a "T.Method" name.
+func AnalyzeFile(fset *token.FileSet, file *ast.File, path string) []Result {
+	var results []Result
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		results = append(results, Result{
+			File:       path,
+			Func:       funcName(fn),
+			Cyclomatic: Cyclomatic(fn),
+			Cognitive:  Cognitive(fn),
+			Lines:      fset.Position(fn.End()).Line - fset.Position(fn.Pos()).Line + 1,
+		})
+	}
+	return results
+}
+
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
+
+// Cyclomatic computes McCabe cyclomatic complexity: 1 plus one for every
+// decision point (if, for, range, non-default case/comm clause, && and ||)
+// plus one for every goto label that more than one goto statement in fn
+// targets, since each such label is an extra independent path through the
+// function beyond the one the label's single occurrence in the source
+// would suggest.
+func Cyclomatic(fn *ast.FuncDecl) int {
+	count := 1
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.IfStmt:
+			count++
+		case *ast.ForStmt:
+			count++
+		case *ast.RangeStmt:
+			count++
+		case *ast.CaseClause:
+			if x.List != nil { // nil List means the default clause
+				count++
+			}
+		case *ast.CommClause:
+			if x.Comm != nil { // nil Comm means the default clause
+				count++
+			}
+		case *ast.BinaryExpr:
+			if x.Op == token.LAND || x.Op == token.LOR {
+				count++
+			}
+		}
+		return true
+	})
+
+	gotos := map[string]int{}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		branch, ok := n.(*ast.BranchStmt)
+		if ok && branch.Tok == token.GOTO {
+			gotos[branch.Label.Name]++
+		}
+		return true
+	})
+	for _, n := range gotos {
+		if n > 1 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Cognitive computes Sonar-style cognitive complexity: each control
+// structure adds 1 plus the current nesting depth, an else/else-if adds a
+// flat 1 with no nesting penalty of its own, each goto adds a flat 1, and
+// each run of the same operator within a chain of && / || adds 1 (so
+// "a && b && c" adds 1 and "a && b || c" adds 2).
+func Cognitive(fn *ast.FuncDecl) int {
+	c := &cognitiveWalker{}
+	c.walkStmts(fn.Body.List, 0)
+	return c.score
+}
+
+type cognitiveWalker struct {
+	score int
+}
+
+func (c *cognitiveWalker) walkStmts(stmts []ast.Stmt, nesting int) {
+	for _, s := range stmts {
+		c.walkStmt(s, nesting)
+	}
+}
+
+func (c *cognitiveWalker) walkStmt(stmt ast.Stmt, nesting int) {
+	switch s := stmt.(type) {
+	case *ast.LabeledStmt:
+		c.walkStmt(s.Stmt, nesting)
+
+	case *ast.BlockStmt:
+		c.walkStmts(s.List, nesting)
+
+	case *ast.BranchStmt:
+		if s.Tok == token.GOTO {
+			c.score++
+		}
+
+	case *ast.IfStmt:
+		c.score += 1 + nesting
+		c.countBoolSeq(s.Cond)
+		c.walkStmt(s.Body, nesting+1)
+		c.walkElse(s.Else, nesting)
+
+	case *ast.ForStmt:
+		c.score += 1 + nesting
+		c.countBoolSeq(s.Cond)
+		c.walkStmt(s.Body, nesting+1)
+
+	case *ast.RangeStmt:
+		c.score += 1 + nesting
+		c.walkStmt(s.Body, nesting+1)
+
+	case *ast.SwitchStmt:
+		c.score += 1 + nesting
+		for _, clause := range s.Body.List {
+			cc := clause.(*ast.CaseClause)
+			c.walkStmts(cc.Body, nesting+1)
+		}
+
+	case *ast.TypeSwitchStmt:
+		c.score += 1 + nesting
+		for _, clause := range s.Body.List {
+			cc := clause.(*ast.CaseClause)
+			c.walkStmts(cc.Body, nesting+1)
+		}
+
+	case *ast.SelectStmt:
+		c.score += 1 + nesting
+		for _, clause := range s.Body.List {
+			cc := clause.(*ast.CommClause)
+			c.walkStmts(cc.Body, nesting+1)
+		}
+	}
+}
+
+// walkElse handles the else branch of an if statement: an "else if" adds
+// a flat 1 (no nesting increment of its own) and may chain further, while
+// a plain "else" block adds a flat 1 and nests its contents one level
+// deeper than the enclosing if.
+func (c *cognitiveWalker) walkElse(els ast.Stmt, nesting int) {
+	switch e := els.(type) {
+	case nil:
+		return
+	case *ast.IfStmt:
+		c.score++
+		c.countBoolSeq(e.Cond)
+		c.walkStmt(e.Body, nesting+1)
+		c.walkElse(e.Else, nesting)
+	default:
+		c.score++
+		c.walkStmt(els, nesting+1)
+	}
+}
+
+// countBoolSeq adds 1 for each point where a chain of && / || operators in
+// cond changes from one operator to the other, including the first
+// operator in the chain.
+func (c *cognitiveWalker) countBoolSeq(cond ast.Expr) {
+	ops := flattenBoolOps(cond)
+	var prev token.Token
+	for i, op := range ops {
+		if i == 0 || op != prev {
+			c.score++
+		}
+		prev = op
+	}
+}
+
+// flattenBoolOps returns the && / || operators in expr's top-level boolean
+// chain, in left-to-right order, stopping at any non-boolean subexpression
+// (e.g. the == inside "a == b && c == d" is not itself part of the chain).
+func flattenBoolOps(expr ast.Expr) []token.Token {
+	bin, ok := expr.(*ast.BinaryExpr)
+	if !ok || (bin.Op != token.LAND && bin.Op != token.LOR) {
+		return nil
+	}
+	ops := flattenBoolOps(bin.X)
+	ops = append(ops, bin.Op)
+	return append(ops, flattenBoolOps(bin.Y)...)
+}
@@ -0,0 +1,129 @@
+package complexity_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/SkanUJkod/SkanUJkod/pkg/metrics/complexity"
+)
+
+func parseFunc(t *testing.T, src string) *ast.FuncDecl {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "fixture.go", "package p\n"+src, 0)
+	if err != nil {
+		t.Fatalf("parsing fixture: %v", err)
+	}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			return fn
+		}
+	}
+	t.Fatal("fixture has no function declaration")
+	return nil
+}
+
+const complexFunctionSrc = `
+func complexFunction(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	if n == 1 {
+		return 1
+	}
+
+	result := 0
+	for i := 0; i < n; i++ {
+		if i%2 == 0 {
+			result += i
+		} else {
+			result -= i
+		}
+
+		if i > 5 {
+			break
+		}
+	}
+
+	return result
+}
+`
+
+const complexFlowSrc = `
+func complexFlow(n int) int {
+	result := 0
+	i := 0
+
+loop:
+	if i >= n {
+		goto end
+	}
+	result += i
+	i++
+	goto loop
+
+end:
+	return result
+}
+`
+
+func TestComplexFunction(t *testing.T) {
+	fn := parseFunc(t, complexFunctionSrc)
+	if got := complexity.Cyclomatic(fn); got != 6 {
+		t.Errorf("Cyclomatic(complexFunction) = %d; want 6", got)
+	}
+	if got := complexity.Cognitive(fn); got != 8 {
+		t.Errorf("Cognitive(complexFunction) = %d; want 8", got)
+	}
+}
+
+func TestComplexFlow(t *testing.T) {
+	fn := parseFunc(t, complexFlowSrc)
+	if got := complexity.Cyclomatic(fn); got != 2 {
+		t.Errorf("Cyclomatic(complexFlow) = %d; want 2", got)
+	}
+	if got := complexity.Cognitive(fn); got != 3 {
+		t.Errorf("Cognitive(complexFlow) = %d; want 3", got)
+	}
+}
+
+func TestCyclomatic_MultiSiteGotoAddsAPath(t *testing.T) {
+	const src = `
+func retry(n int) int {
+	tries := 0
+retry:
+	tries++
+	if tries > n {
+		goto retry
+	}
+	if tries == 0 {
+		goto retry
+	}
+	return tries
+}
+`
+	fn := parseFunc(t, src)
+	// 1 (base) + 2 (if) + 1 (label "retry" targeted by two gotos) = 4.
+	if got := complexity.Cyclomatic(fn); got != 4 {
+		t.Errorf("Cyclomatic(retry) = %d; want 4", got)
+	}
+}
+
+func TestCognitive_BooleanOperatorChangeCountsOnce(t *testing.T) {
+	const src = `
+func classify(a, b, c bool) int {
+	if a && b && c {
+		return 1
+	}
+	return 0
+}
+`
+	fn := parseFunc(t, src)
+	// 1 (if) + 1 (first && in the chain; the second && is the same
+	// operator so it doesn't add again) = 2.
+	if got := complexity.Cognitive(fn); got != 2 {
+		t.Errorf("Cognitive(classify) = %d; want 2", got)
+	}
+}
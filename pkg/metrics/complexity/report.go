@@ -0,0 +1,31 @@
+package complexity
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// JSON renders results as an indented JSON array, one object per function.
+func JSON(results []Result) ([]byte, error) {
+	return json.MarshalIndent(results, "", "  ")
+}
+
+// Markdown renders results as a Markdown table sorted by cognitive
+// complexity, highest first.
+func Markdown(results []Result) string {
+	sorted := make([]Result, len(results))
+	copy(sorted, results)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Cognitive > sorted[j].Cognitive
+	})
+
+	var b strings.Builder
+	b.WriteString("| File | Func | Cyclomatic | Cognitive | Lines |\n")
+	b.WriteString("|---|---|---|---|---|\n")
+	for _, r := range sorted {
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %d |\n", r.File, r.Func, r.Cyclomatic, r.Cognitive, r.Lines)
+	}
+	return b.String()
+}
@@ -0,0 +1,115 @@
+package testaudit
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// UncoveredFunc is a top-level function with no coverage.
+type UncoveredFunc struct {
+	File string
+	Func string
+}
+
+// FuncCoverage runs "go test -cover" for pkgPattern (executed with dir as
+// the working directory) and returns each function's coverage percentage,
+// keyed the same way UncoveredFuncs keys a source path (see
+// funcCoverageKey).
+func FuncCoverage(dir, pkgPattern string) (map[string]float64, error) {
+	profile, err := os.CreateTemp("", "testaudit-cover-*.out")
+	if err != nil {
+		return nil, err
+	}
+	profilePath := profile.Name()
+	profile.Close()
+	defer os.Remove(profilePath)
+
+	test := exec.Command("go", "test", "-coverprofile="+profilePath, pkgPattern)
+	test.Dir = dir
+	// A failing test still produces a coverage profile for whatever ran
+	// before the failure; only a tooling error (no go.mod, bad pattern,
+	// ...) should stop the audit.
+	if err := test.Run(); err != nil {
+		if _, statErr := os.Stat(profilePath); statErr != nil {
+			return nil, fmt.Errorf("go test -coverprofile: %w", err)
+		}
+	}
+
+	out, err := exec.Command("go", "tool", "cover", "-func="+profilePath).Output()
+	if err != nil {
+		return nil, fmt.Errorf("go tool cover -func: %w", err)
+	}
+	return parseFuncCoverage(out), nil
+}
+
+var funcCoverageLine = regexp.MustCompile(`^(\S+\.go):(\d+):\s+(\S+)\s+([\d.]+)%$`)
+
+// parseFuncCoverage keys on the source file's parent directory plus base
+// name rather than the full path "go tool cover -func" prints, which is
+// the function's package import path plus filename (e.g.
+// "github.com/SkanUJkod/SkanUJkod/example-go/main.go") — not a path
+// UncoveredFuncs, working from a plain filesystem argument, could ever
+// reproduce.
+func parseFuncCoverage(out []byte) map[string]float64 {
+	coverage := map[string]float64{}
+	scanner := bufio.NewScanner(strings.NewReader(string(out)))
+	for scanner.Scan() {
+		m := funcCoverageLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue // e.g. the trailing "total:" line
+		}
+		pct, err := strconv.ParseFloat(m[4], 64)
+		if err != nil {
+			continue
+		}
+		coverage[funcCoverageKey(m[1], m[3])] = pct
+	}
+	return coverage
+}
+
+// funcCoverageKey keys on the file's parent directory name plus its base
+// name, e.g. "example-go/main.go:calculateGrade", instead of the base name
+// alone. Keying purely on the base name would collide whenever two packages
+// happen to share a filename — this repo already has two files named
+// main.go (example-go and go-code/example-go2). This is still not a full
+// import-path match (two packages could share both a parent directory name
+// and a filename), but it resolves every collision that can actually arise
+// between sibling packages laid out the way this repo's are.
+func funcCoverageKey(file, funcName string) string {
+	dir := filepath.Base(filepath.Dir(file))
+	return filepath.Join(dir, filepath.Base(file)) + ":" + funcName
+}
+
+// UncoveredFuncs returns every top-level function in a non-test source
+// file that coverage has no entry for, or reports as 0%. Methods and main
+// are skipped.
+func UncoveredFuncs(sourceFile string, coverage map[string]float64) ([]UncoveredFunc, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, sourceFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var uncovered []UncoveredFunc
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Name.Name == "main" {
+			continue
+		}
+		key := funcCoverageKey(sourceFile, fn.Name.Name)
+		if coverage[key] > 0 {
+			continue
+		}
+		uncovered = append(uncovered, UncoveredFunc{File: sourceFile, Func: fn.Name.Name})
+	}
+	return uncovered, nil
+}
@@ -0,0 +1,100 @@
+package testaudit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseFuncCoverage(t *testing.T) {
+	const out = `github.com/SkanUJkod/SkanUJkod/example-go/main.go:7:		calculateGrade		0.0%
+github.com/SkanUJkod/SkanUJkod/example-go/main.go:20:		sumArray		100.0%
+total:						(statements)		45.5%
+`
+	got := parseFuncCoverage([]byte(out))
+
+	if pct, ok := got["example-go/main.go:calculateGrade"]; !ok || pct != 0.0 {
+		t.Errorf("calculateGrade coverage = %v, %v; want 0.0, true", pct, ok)
+	}
+	if pct, ok := got["example-go/main.go:sumArray"]; !ok || pct != 100.0 {
+		t.Errorf("sumArray coverage = %v, %v; want 100.0, true", pct, ok)
+	}
+	if _, ok := got["total:"]; ok {
+		t.Error("the trailing total: line should not produce an entry")
+	}
+}
+
+// TestUncoveredFuncsMatchesRealCoverOutput exercises parseFuncCoverage and
+// UncoveredFuncs together the way runTestAudit actually calls them: the
+// coverage map is keyed by the import-path-qualified filename "go tool
+// cover -func" prints, while the source file handed to UncoveredFuncs is
+// just a short, unqualified filesystem path. If the two don't key the same
+// way, every function looks uncovered regardless of real coverage.
+func TestUncoveredFuncsMatchesRealCoverOutput(t *testing.T) {
+	const out = `github.com/SkanUJkod/SkanUJkod/example-go/main.go:7:		calculateGrade		0.0%
+github.com/SkanUJkod/SkanUJkod/example-go/main.go:20:		sumArray		100.0%
+total:						(statements)		45.5%
+`
+	coverage := parseFuncCoverage([]byte(out))
+
+	dir := filepath.Join(t.TempDir(), "example-go")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	source := filepath.Join(dir, "main.go")
+	const src = `package main
+
+func calculateGrade(score int) string { return "" }
+func sumArray(nums []int) int          { return 0 }
+`
+	if err := os.WriteFile(source, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	uncovered, err := UncoveredFuncs(source, coverage)
+	if err != nil {
+		t.Fatalf("UncoveredFuncs: %v", err)
+	}
+	if len(uncovered) != 1 || uncovered[0].Func != "calculateGrade" {
+		t.Fatalf("uncovered = %+v, want just calculateGrade", uncovered)
+	}
+}
+
+// TestFuncCoverageKeyDoesNotCollideAcrossSiblingPackages is the regression
+// case from the review: two packages whose source files share a base name
+// (this repo already has two files named main.go, in example-go and
+// go-code/example-go2) must not have one package's coverage mask the
+// other's.
+func TestFuncCoverageKeyDoesNotCollideAcrossSiblingPackages(t *testing.T) {
+	const out = `github.com/SkanUJkod/SkanUJkod/example-go/main.go:7:		calculateGrade		100.0%
+github.com/SkanUJkod/SkanUJkod/go-code/example-go2/main.go:7:		calculateGrade		0.0%
+`
+	coverage := parseFuncCoverage([]byte(out))
+
+	root := t.TempDir()
+	makeSource := func(pkgDir string) string {
+		dir := filepath.Join(root, pkgDir)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		source := filepath.Join(dir, "main.go")
+		const src = `package main
+
+func calculateGrade(score int) string { return "" }
+`
+		if err := os.WriteFile(source, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return source
+	}
+
+	covered := makeSource("example-go")
+	uncovered := makeSource("go-code/example-go2")
+
+	if got, err := UncoveredFuncs(covered, coverage); err != nil || len(got) != 0 {
+		t.Errorf("UncoveredFuncs(%q) = %+v, %v; want none uncovered", covered, got, err)
+	}
+	if got, err := UncoveredFuncs(uncovered, coverage); err != nil || len(got) != 1 {
+		t.Errorf("UncoveredFuncs(%q) = %+v, %v; want calculateGrade uncovered", uncovered, got, err)
+	}
+}
@@ -0,0 +1,158 @@
+// Package testaudit finds coverage gaps a plain "go test -cover" run can't
+// see on its own: test functions that were commented out wholesale (so
+// they silently stop contributing coverage) and the functions in the same
+// package that no test, disabled or not, ever exercises.
+package testaudit
+
+import (
+	"bufio"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// DisabledTest is a commented-out func Test... found inside a _test.go
+// file.
+type DisabledTest struct {
+	File string
+	Line int
+	Name string
+	Body string
+}
+
+// FindDisabledTests scans filename for contiguous blocks of "//"-style
+// comment lines (tolerating blank lines inside the block, since a
+// commented-out test is usually the result of selecting the function body
+// and pressing comment-toggle, stray blank line included) that parse as a
+// "func Test...(t *testing.T)" declaration once every "//" is stripped,
+// and reports each one's location.
+func FindDisabledTests(filename string) ([]DisabledTest, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []DisabledTest
+	for _, block := range commentBlocks(string(src)) {
+		name, ok := disabledTestName(block.body)
+		if !ok {
+			continue
+		}
+		found = append(found, DisabledTest{
+			File: filename,
+			Line: block.startLine,
+			Name: name,
+			Body: block.body,
+		})
+	}
+	return found, nil
+}
+
+type rawBlock struct {
+	startLine int // 1-based
+	rawLines  []string
+	body      string // rawLines with "//" stripped, blank lines preserved
+}
+
+// commentBlocks splits src into maximal runs of consecutive "//" comment
+// lines and blank lines, each bounded by ordinary code (or the start/end
+// of the file). Each run is a separate candidate the caller checks for a
+// disabled test.
+func commentBlocks(src string) []rawBlock {
+	var blocks []rawBlock
+	var cur *rawBlock
+
+	flush := func() {
+		if cur == nil {
+			return
+		}
+		// Trim trailing blank lines; they're not part of the candidate body.
+		for len(cur.rawLines) > 0 && strings.TrimSpace(cur.rawLines[len(cur.rawLines)-1]) == "" {
+			cur.rawLines = cur.rawLines[:len(cur.rawLines)-1]
+		}
+		if len(cur.rawLines) > 0 {
+			cur.body = strings.Join(uncomment(cur.rawLines), "\n")
+			blocks = append(blocks, *cur)
+		}
+		cur = nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(src))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "//"):
+			if cur == nil {
+				cur = &rawBlock{startLine: lineNo}
+			}
+			cur.rawLines = append(cur.rawLines, line)
+		case trimmed == "":
+			if cur != nil {
+				cur.rawLines = append(cur.rawLines, line)
+			}
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+func uncomment(lines []string) []string {
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			out[i] = ""
+			continue
+		}
+		indent := line[:strings.Index(line, "//")]
+		rest := strings.TrimPrefix(strings.TrimPrefix(trimmed, "//"), " ")
+		out[i] = indent + rest
+	}
+	return out
+}
+
+// disabledTestName reports whether body parses as a single top-level
+// "func TestXxx(t *testing.T)" declaration, and if so, returns its name.
+func disabledTestName(body string) (string, bool) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "disabled.go", "package p\n"+body, 0)
+	if err != nil || len(file.Decls) != 1 {
+		return "", false
+	}
+	fn, ok := file.Decls[0].(*ast.FuncDecl)
+	if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+		return "", false
+	}
+	if !isTestingTParam(fn) {
+		return "", false
+	}
+	return fn.Name.Name, true
+}
+
+// isTestingTParam reports whether fn has exactly one parameter of type
+// *testing.T.
+func isTestingTParam(fn *ast.FuncDecl) bool {
+	params := fn.Type.Params.List
+	if len(params) != 1 || len(params[0].Names) != 1 {
+		return false
+	}
+	star, ok := params[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "testing" && sel.Sel.Name == "T"
+}
@@ -0,0 +1,137 @@
+package testaudit
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleTestFile = `package main
+
+import "testing"
+
+// func TestCalculateGrade(t *testing.T) {
+//     tests := []struct {
+//         score    int
+//         expected string
+//     }{
+//         {95, "A"},
+//     }
+
+//     for _, tt := range tests {
+//         result := calculateGrade(tt.score)
+//         if result != tt.expected {
+//             t.Errorf("calculateGrade(%d) = %s; want %s",
+//                 tt.score, result, tt.expected)
+//         }
+//     }
+// }
+
+func TestSumArray(t *testing.T) {
+	if sumArray([]int{1, 2}) != 3 {
+		t.Fatal("bad sum")
+	}
+}
+
+// This is just an explanatory comment, not a disabled test.
+func TestFindMax(t *testing.T) {
+}
+`
+
+func writeSample(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main_test.go")
+	if err := os.WriteFile(path, []byte(sampleTestFile), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFindDisabledTests(t *testing.T) {
+	path := writeSample(t)
+
+	found, err := FindDisabledTests(path)
+	if err != nil {
+		t.Fatalf("FindDisabledTests: %v", err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("found %d disabled test(s), want 1: %+v", len(found), found)
+	}
+	if found[0].Name != "TestCalculateGrade" {
+		t.Errorf("disabled test name = %q, want %q", found[0].Name, "TestCalculateGrade")
+	}
+	if found[0].Line != 5 {
+		t.Errorf("disabled test line = %d, want 5", found[0].Line)
+	}
+}
+
+func TestRevivePatchUncommentsOnlyTheDisabledTest(t *testing.T) {
+	path := writeSample(t)
+
+	patch, err := RevivePatch(path)
+	if err != nil {
+		t.Fatalf("RevivePatch: %v", err)
+	}
+	if !strings.Contains(patch, "+func TestCalculateGrade(t *testing.T) {") {
+		t.Errorf("patch does not uncomment TestCalculateGrade:\n%s", patch)
+	}
+	if strings.Contains(patch, "explanatory comment") {
+		t.Errorf("patch touched the unrelated explanatory comment:\n%s", patch)
+	}
+}
+
+// TestRevivePatchAppliesWithPlainGitApply checks the patch with the same
+// tool a reviewer would actually reach for: plain "git apply", which (unlike
+// "patch -p1" or "git apply --unidiff-zero") refuses a hunk with no
+// unchanged context around it.
+func TestRevivePatchAppliesWithPlainGitApply(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main_test.go")
+	if err := os.WriteFile(path, []byte(sampleTestFile), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.email=test@example.com", "-c", "user.name=test", "add", "main_test.go"},
+		{"-c", "user.email=test@example.com", "-c", "user.name=test", "commit", "-q", "-m", "initial"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	old, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(old)
+
+	patch, err := RevivePatch("main_test.go")
+	if err != nil {
+		t.Fatalf("RevivePatch: %v", err)
+	}
+	if patch == "" {
+		t.Fatal("RevivePatch returned an empty patch")
+	}
+	if err := os.WriteFile("revive.patch", []byte(patch), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("git", "apply", "--check", "revive.patch")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("plain \"git apply --check\" rejected the patch: %v\n%s\npatch:\n%s", err, out, patch)
+	}
+}
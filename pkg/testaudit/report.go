@@ -0,0 +1,38 @@
+package testaudit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Report is the combined result of an audit: tests that were disabled by
+// commenting them out, and functions nothing — disabled or not — covers.
+type Report struct {
+	DisabledTests  []DisabledTest
+	UncoveredFuncs []UncoveredFunc
+}
+
+// String renders the report so a reviewer sees both halves together: a
+// function can show up in both sections, which is the case that matters
+// most (a disabled test was the only thing that would have covered it).
+func (r Report) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Disabled tests (%d):\n", len(r.DisabledTests))
+	for _, t := range r.DisabledTests {
+		fmt.Fprintf(&b, "  %s:%d: %s\n", t.File, t.Line, t.Name)
+	}
+	if len(r.DisabledTests) == 0 {
+		b.WriteString("  (none)\n")
+	}
+
+	fmt.Fprintf(&b, "\nFunctions with no coverage (%d):\n", len(r.UncoveredFuncs))
+	for _, u := range r.UncoveredFuncs {
+		fmt.Fprintf(&b, "  %s: %s\n", u.File, u.Func)
+	}
+	if len(r.UncoveredFuncs) == 0 {
+		b.WriteString("  (none)\n")
+	}
+
+	return b.String()
+}
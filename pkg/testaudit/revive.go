@@ -0,0 +1,70 @@
+package testaudit
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// contextLines is how many unchanged lines RevivePatch includes on each
+// side of a hunk. Plain "git apply" (unlike "patch -p1" or
+// "git apply --unidiff-zero") rejects a hunk with no context at all, so
+// a zero-context diff here would be useless to the reviewer it's for.
+const contextLines = 3
+
+// RevivePatch returns a unified diff that uncomments every disabled test
+// FindDisabledTests recognizes in filename, so a reviewer can inspect and
+// apply it deliberately instead of having tests silently re-enabled.
+func RevivePatch(filename string) (string, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(string(src), "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	var hunks strings.Builder
+	for _, block := range commentBlocks(string(src)) {
+		if _, ok := disabledTestName(block.body); !ok {
+			continue
+		}
+
+		// block.startLine is 1-based; before/after are 0-based slice indices
+		// into lines for the unchanged context surrounding the block.
+		before := block.startLine - 1 - contextLines
+		if before < 0 {
+			before = 0
+		}
+		afterEnd := block.startLine - 1 + len(block.rawLines) + contextLines
+		if afterEnd > len(lines) {
+			afterEnd = len(lines)
+		}
+		beforeCtx := lines[before : block.startLine-1]
+		afterCtx := lines[block.startLine-1+len(block.rawLines) : afterEnd]
+
+		newLines := strings.Split(block.body, "\n")
+		oldCount := len(beforeCtx) + len(block.rawLines) + len(afterCtx)
+		newCount := len(beforeCtx) + len(newLines) + len(afterCtx)
+		startLine := before + 1
+		fmt.Fprintf(&hunks, "@@ -%d,%d +%d,%d @@\n", startLine, oldCount, startLine, newCount)
+		for _, l := range beforeCtx {
+			fmt.Fprintf(&hunks, " %s\n", l)
+		}
+		for _, l := range block.rawLines {
+			fmt.Fprintf(&hunks, "-%s\n", l)
+		}
+		for _, l := range newLines {
+			fmt.Fprintf(&hunks, "+%s\n", l)
+		}
+		for _, l := range afterCtx {
+			fmt.Fprintf(&hunks, " %s\n", l)
+		}
+	}
+
+	if hunks.Len() == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("--- a/%s\n+++ b/%s\n%s", filename, filename, hunks.String()), nil
+}